@@ -1,4 +1,24 @@
 package main
 
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+)
+
+// Populated at build time via -ldflags "-X main.version=... -X main.commit=..."
+var (
+	version = "unknown"
+	commit  = "unknown"
+)
+
 func main() {
+	printVersion := flag.Bool("version", false, "print version information and exit")
+	flag.Parse()
+
+	if *printVersion {
+		fmt.Fprintf(os.Stdout, "cloud-network-config-controller version: %s, commit: %s, go: %s\n", version, commit, runtime.Version())
+		os.Exit(0)
+	}
 }